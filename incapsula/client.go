@@ -0,0 +1,89 @@
+package incapsula
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Endpoints
+const endpointAddSite = "sites/add"
+const endpointSiteStatus = "site"
+const endpointDeleteSite = "sites/delete"
+const endpointSiteConfigure = "sites/configure"
+
+// Client represents an internal client that brokers calls to the Incapsula API
+type Client struct {
+	config     *Config
+	httpClient *http.Client
+}
+
+// Config represents the configuration used to create the Client
+type Config struct {
+	APIID    string
+	APIKey   string
+	Endpoint string
+
+	// AccountID is the provider-level default account to operate on. It is
+	// used by resources whose own account_id field is left empty.
+	AccountID string
+}
+
+// Client creates a new Incapsula client given a Config
+func (c *Config) Client() (*Client, error) {
+	client := &Client{
+		config: c,
+		httpClient: &http.Client{
+			Timeout: time.Duration(10 * time.Second),
+		},
+	}
+
+	return client, nil
+}
+
+// AccountID returns the provider-level default account ID, used by
+// resources whose own account_id field is left empty.
+func (c *Client) AccountID() string {
+	return c.config.AccountID
+}
+
+// postForm executes an HTTP POST request against the Incapsula API using the
+// provided form values and returns the response body
+func (c *Client) postForm(endpoint string, values url.Values) ([]byte, error) {
+	values.Set("api_id", c.config.APIID)
+	values.Set("api_key", c.config.APIKey)
+
+	reqURL := fmt.Sprintf("%s/%s", c.config.Endpoint, endpoint)
+
+	log.Printf("[DEBUG] Incapsula POST request to %s: %s\n", reqURL, values)
+
+	resp, err := c.httpClient.PostForm(reqURL, values)
+	if err != nil {
+		return nil, fmt.Errorf("error executing POST request to %s: %s", reqURL, err)
+	}
+	defer resp.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response from %s: %s", reqURL, err)
+	}
+
+	log.Printf("[DEBUG] Incapsula response from %s: %s\n", reqURL, string(responseBody))
+
+	return responseBody, nil
+}
+
+// trimmedValues discards empty strings so optional fields are not sent to the API
+func trimmedValues(fields map[string]string) url.Values {
+	values := url.Values{}
+	for key, value := range fields {
+		if strings.TrimSpace(value) != "" {
+			values.Set(key, value)
+		}
+	}
+	return values
+}
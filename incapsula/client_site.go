@@ -0,0 +1,176 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// SiteAddResponse contains the relevant site information when adding an Incapsula managed site
+type SiteAddResponse struct {
+	SiteID int    `json:"site_id"`
+	Res    int    `json:"res"`
+	ResMsg string `json:"res_message"`
+}
+
+// SiteStatusResponse contains the relevant site information when querying Incapsula site status
+type SiteStatusResponse struct {
+	SiteID           int    `json:"site_id"`
+	Domain           string `json:"domain"`
+	AccountID        int    `json:"account_id"`
+	Res              int    `json:"res"`
+	ResMsg           string `json:"res_message"`
+	Status           string `json:"status"`
+	SiteCreationDate int    `json:"site_creation_date"`
+	DNS              []struct {
+		DNSRecordName string   `json:"dns_record_name"`
+		SetTypeTo     string   `json:"set_type_to"`
+		SetDataTo     []string `json:"set_data_to"`
+	} `json:"dns"`
+}
+
+// genericResponse is returned by endpoints that only report success/failure
+type genericResponse struct {
+	Res    int    `json:"res"`
+	ResMsg string `json:"res_message"`
+}
+
+// AddSite adds a new site to be managed by Incapsula
+func (c *Client) AddSite(domain, accountID, refID, sendSiteSetupEmails, siteIP, forceSSL, logLevel, logsAccountID string) (*SiteAddResponse, error) {
+	values := trimmedValues(map[string]string{
+		"domain":                 domain,
+		"account_id":             accountID,
+		"ref_id":                 refID,
+		"send_site_setup_emails": sendSiteSetupEmails,
+		"site_ip":                siteIP,
+		"force_ssl":              forceSSL,
+		"log_level":              logLevel,
+		"logs_account_id":        logsAccountID,
+	})
+
+	responseBody, err := c.postForm(endpointAddSite, values)
+	if err != nil {
+		return nil, fmt.Errorf("error adding site for domain %s: %s", domain, err)
+	}
+
+	var siteAddResponse SiteAddResponse
+	err = json.Unmarshal(responseBody, &siteAddResponse)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing add site response for domain %s: %s", domain, err)
+	}
+
+	if siteAddResponse.Res != 0 {
+		return nil, fmt.Errorf("error from Incapsula service when adding site for domain %s: %s", domain, siteAddResponse.ResMsg)
+	}
+
+	return &siteAddResponse, nil
+}
+
+// SiteStatus gets the current status of a site, identified either by its
+// numeric site ID or, if siteID is 0, by its domain
+func (c *Client) SiteStatus(domain string, siteID int) (*SiteStatusResponse, error) {
+	values := url.Values{}
+	if siteID != 0 {
+		values.Set("site_id", strconv.Itoa(siteID))
+	} else {
+		values.Set("domain", domain)
+	}
+
+	responseBody, err := c.postForm(endpointSiteStatus, values)
+	if err != nil {
+		return nil, fmt.Errorf("error getting site status for domain %s (site id %d): %s", domain, siteID, err)
+	}
+
+	var siteStatusResponse SiteStatusResponse
+	err = json.Unmarshal(responseBody, &siteStatusResponse)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing site status response for domain %s (site id %d): %s", domain, siteID, err)
+	}
+
+	if siteStatusResponse.Res != 0 {
+		return nil, fmt.Errorf("error from Incapsula service when getting site status for domain %s (site id %d): %s", domain, siteID, siteStatusResponse.ResMsg)
+	}
+
+	return &siteStatusResponse, nil
+}
+
+// updateSiteParam invokes the site configure endpoint to set a single param/value pair
+func (c *Client) updateSiteParam(siteID int, paramName, paramValue string) error {
+	values := url.Values{
+		"site_id": {strconv.Itoa(siteID)},
+		"param":   {paramName},
+		"value":   {paramValue},
+	}
+
+	responseBody, err := c.postForm(endpointSiteConfigure, values)
+	if err != nil {
+		return fmt.Errorf("error updating site param %s for site id %d: %s", paramName, siteID, err)
+	}
+
+	var updateSiteResponse genericResponse
+	err = json.Unmarshal(responseBody, &updateSiteResponse)
+	if err != nil {
+		return fmt.Errorf("error parsing update site param %s response for site id %d: %s", paramName, siteID, err)
+	}
+
+	if updateSiteResponse.Res != 0 {
+		return fmt.Errorf("error from Incapsula service when updating site param %s for site id %d: %s", paramName, siteID, updateSiteResponse.ResMsg)
+	}
+
+	return nil
+}
+
+// UpdateSiteIP sets the manual web server IP/CNAME for a site
+func (c *Client) UpdateSiteIP(siteID int, siteIP string) error {
+	return c.updateSiteParam(siteID, "site_ip", siteIP)
+}
+
+// UpdateSiteForceSSL sets whether a site should be forced to use SSL
+func (c *Client) UpdateSiteForceSSL(siteID int, forceSSL string) error {
+	return c.updateSiteParam(siteID, "force_ssl", forceSSL)
+}
+
+// UpdateSiteLogLevel sets the log reporting level for a site
+func (c *Client) UpdateSiteLogLevel(siteID int, logLevel string) error {
+	return c.updateSiteParam(siteID, "log_level", logLevel)
+}
+
+// UpdateSiteLogsAccountID sets the account that collects a site's logs
+func (c *Client) UpdateSiteLogsAccountID(siteID int, logsAccountID string) error {
+	return c.updateSiteParam(siteID, "logs_account_id", logsAccountID)
+}
+
+// UpdateSiteRefID sets the customer specific identifier for a site
+func (c *Client) UpdateSiteRefID(siteID int, refID string) error {
+	return c.updateSiteParam(siteID, "ref_id", refID)
+}
+
+// UpdateSiteSendSiteSetupEmails sets whether Incapsula sends site setup emails for a site
+func (c *Client) UpdateSiteSendSiteSetupEmails(siteID int, sendSiteSetupEmails string) error {
+	return c.updateSiteParam(siteID, "send_site_setup_emails", sendSiteSetupEmails)
+}
+
+// DeleteSite deletes a site currently managed by Incapsula
+func (c *Client) DeleteSite(domain string, siteID int) error {
+	values := url.Values{
+		"site_id": {strconv.Itoa(siteID)},
+	}
+
+	responseBody, err := c.postForm(endpointDeleteSite, values)
+	if err != nil {
+		return fmt.Errorf("error deleting site for domain %s (site id %d): %s", domain, siteID, err)
+	}
+
+	var deleteSiteResponse genericResponse
+	err = json.Unmarshal(responseBody, &deleteSiteResponse)
+	if err != nil {
+		return fmt.Errorf("error parsing delete site response for domain %s (site id %d): %s", domain, siteID, err)
+	}
+
+	if deleteSiteResponse.Res != 0 {
+		return fmt.Errorf("error from Incapsula service when deleting site for domain %s (site id %d): %s", domain, siteID, deleteSiteResponse.ResMsg)
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package incapsula
+
+import "testing"
+
+func TestClientAccountID(t *testing.T) {
+	config := &Config{AccountID: "12345"}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if client.AccountID() != "12345" {
+		t.Fatalf("expected account id 12345, got %s", client.AccountID())
+	}
+}
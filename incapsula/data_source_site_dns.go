@@ -0,0 +1,98 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceSiteDNS() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSiteDNSRead,
+
+		Schema: map[string]*schema.Schema{
+			// Optional Arguments - at least one of site_id or domain must be set;
+			// site_id is used for the lookup when both are set
+			"site_id": &schema.Schema{
+				Description: "Numeric identifier of the Incapsula site, for example incapsula_site.foo.id. One of site_id or domain must be set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"domain": &schema.Schema{
+				Description: "The domain name of the Incapsula site. One of site_id or domain must be set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			// Computed Attributes
+			"dns_cname_record_name": &schema.Schema{
+				Description: "CNAME record name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"dns_cname_record_value": &schema.Schema{
+				Description: "CNAME record value.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"dns_a_record_name": &schema.Schema{
+				Description: "A record name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"dns_a_record_value": &schema.Schema{
+				Description: "A record value.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSiteDNSRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	siteIDArg := d.Get("site_id").(string)
+	domain := d.Get("domain").(string)
+
+	if siteIDArg == "" && domain == "" {
+		return fmt.Errorf("one of site_id or domain must be set")
+	}
+
+	siteID, _ := strconv.Atoi(siteIDArg)
+
+	log.Printf("[INFO] Reading Incapsula site DNS for site id: %d, domain: %s\n", siteID, domain)
+
+	siteStatusResponse, err := client.SiteStatus(domain, siteID)
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula site id: %d, domain: %s, %s\n", siteID, domain, err)
+		return err
+	}
+
+	d.SetId(strconv.Itoa(siteStatusResponse.SiteID))
+	d.Set("site_id", strconv.Itoa(siteStatusResponse.SiteID))
+	d.Set("domain", siteStatusResponse.Domain)
+
+	dnsARecordValues := make([]string, 0)
+	for _, entry := range siteStatusResponse.DNS {
+		if entry.SetTypeTo == "CNAME" && len(entry.SetDataTo) > 0 {
+			d.Set("dns_cname_record_name", entry.DNSRecordName)
+			d.Set("dns_cname_record_value", entry.SetDataTo[0])
+		}
+		if entry.SetTypeTo == "A" {
+			d.Set("dns_a_record_name", entry.DNSRecordName)
+			dnsARecordValues = append(dnsARecordValues, entry.SetDataTo...)
+		}
+	}
+	d.Set("dns_a_record_value", dnsARecordValues)
+
+	log.Printf("[INFO] Read Incapsula site DNS for site id: %d\n", siteID)
+
+	return nil
+}
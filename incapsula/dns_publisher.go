@@ -0,0 +1,31 @@
+package incapsula
+
+import "fmt"
+
+// DNSPublisher publishes the CNAME/A records Incapsula reports for a site
+// into an external DNS provider's zone, and removes them again on delete.
+// It is intentionally narrow (publish one record, unpublish one record) so
+// additional providers can be added without touching resource_dns_record.go.
+type DNSPublisher interface {
+	// PublishRecord creates or updates a record of the given type in the
+	// given zone.
+	PublishRecord(zoneID, name, recordType string, values []string, ttl int) error
+
+	// UnpublishRecord removes a previously published record. The zone,
+	// name, type, values, and TTL must match what was published, since most
+	// DNS APIs require the full record to be specified for deletion.
+	UnpublishRecord(zoneID, name, recordType string, values []string, ttl int) error
+}
+
+// dnsPublisherForType resolves the configured provider name to a concrete
+// DNSPublisher implementation.
+func dnsPublisherForType(providerType string) (DNSPublisher, error) {
+	switch providerType {
+	case "route53":
+		return NewRoute53Publisher()
+	case "clouddns":
+		return NewCloudDNSPublisher()
+	default:
+		return nil, fmt.Errorf("unsupported dns_provider %q, must be one of: route53, clouddns", providerType)
+	}
+}
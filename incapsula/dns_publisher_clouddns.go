@@ -0,0 +1,98 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"google.golang.org/api/dns/v1"
+)
+
+// CloudDNSPublisher implements DNSPublisher against Google Cloud DNS. It
+// authenticates via Application Default Credentials, matching how the
+// Google Terraform provider itself resolves credentials when none are
+// explicitly configured.
+type CloudDNSPublisher struct {
+	service *dns.Service
+	project string
+}
+
+// NewCloudDNSPublisher builds a CloudDNSPublisher using Application Default
+// Credentials. The project is read from the GOOGLE_PROJECT environment
+// variable, the same variable the Google Terraform provider falls back to.
+func NewCloudDNSPublisher() (*CloudDNSPublisher, error) {
+	service, err := dns.NewService(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("error creating Cloud DNS client: %s", err)
+	}
+
+	project := os.Getenv("GOOGLE_PROJECT")
+	if project == "" {
+		return nil, fmt.Errorf("GOOGLE_PROJECT must be set to use the clouddns DNS publisher")
+	}
+
+	return &CloudDNSPublisher{service: service, project: project}, nil
+}
+
+// PublishRecord creates or updates a record set in the given managed zone
+func (p *CloudDNSPublisher) PublishRecord(zoneID, name, recordType string, values []string, ttl int) error {
+	existing, err := p.lookupRecordSet(zoneID, name, recordType)
+	if err != nil {
+		return err
+	}
+
+	change := &dns.Change{
+		Additions: []*dns.ResourceRecordSet{
+			{
+				Name:    name,
+				Type:    recordType,
+				Ttl:     int64(ttl),
+				Rrdatas: values,
+			},
+		},
+	}
+	if existing != nil {
+		change.Deletions = []*dns.ResourceRecordSet{existing}
+	}
+
+	_, err = p.service.Changes.Create(p.project, zoneID, change).Do()
+	if err != nil {
+		return fmt.Errorf("error publishing %s record %s to Cloud DNS zone %s: %s", recordType, name, zoneID, err)
+	}
+
+	return nil
+}
+
+// UnpublishRecord deletes a record set from the given managed zone
+func (p *CloudDNSPublisher) UnpublishRecord(zoneID, name, recordType string, values []string, ttl int) error {
+	change := &dns.Change{
+		Deletions: []*dns.ResourceRecordSet{
+			{
+				Name:    name,
+				Type:    recordType,
+				Ttl:     int64(ttl),
+				Rrdatas: values,
+			},
+		},
+	}
+
+	_, err := p.service.Changes.Create(p.project, zoneID, change).Do()
+	if err != nil {
+		return fmt.Errorf("error unpublishing %s record %s from Cloud DNS zone %s: %s", recordType, name, zoneID, err)
+	}
+
+	return nil
+}
+
+func (p *CloudDNSPublisher) lookupRecordSet(zoneID, name, recordType string) (*dns.ResourceRecordSet, error) {
+	resp, err := p.service.ResourceRecordSets.List(p.project, zoneID).Name(name).Type(recordType).Do()
+	if err != nil {
+		return nil, fmt.Errorf("error looking up existing %s record %s in Cloud DNS zone %s: %s", recordType, name, zoneID, err)
+	}
+
+	if len(resp.Rrsets) == 0 {
+		return nil, nil
+	}
+
+	return resp.Rrsets[0], nil
+}
@@ -0,0 +1,69 @@
+package incapsula
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// Route53Publisher implements DNSPublisher against AWS Route53. It picks up
+// AWS credentials from the standard provider chain (environment, shared
+// config, EC2/ECS role), the same way the rest of the Terraform ecosystem
+// does, rather than taking its own set of credential arguments.
+type Route53Publisher struct {
+	client *route53.Route53
+}
+
+// NewRoute53Publisher builds a Route53Publisher using the default AWS SDK
+// credential chain.
+func NewRoute53Publisher() (*Route53Publisher, error) {
+	sess, err := session.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session for Route53 DNS publisher: %s", err)
+	}
+
+	return &Route53Publisher{client: route53.New(sess)}, nil
+}
+
+// PublishRecord creates or updates a record set in the given hosted zone
+func (p *Route53Publisher) PublishRecord(zoneID, name, recordType string, values []string, ttl int) error {
+	return p.changeRecord(route53.ChangeActionUpsert, zoneID, name, recordType, values, ttl)
+}
+
+// UnpublishRecord deletes a record set from the given hosted zone. Route53
+// requires the full record (including its current values and TTL) to match
+// for a delete to succeed.
+func (p *Route53Publisher) UnpublishRecord(zoneID, name, recordType string, values []string, ttl int) error {
+	return p.changeRecord(route53.ChangeActionDelete, zoneID, name, recordType, values, ttl)
+}
+
+func (p *Route53Publisher) changeRecord(action, zoneID, name, recordType string, values []string, ttl int) error {
+	resourceRecords := make([]*route53.ResourceRecord, len(values))
+	for i, value := range values {
+		resourceRecords[i] = &route53.ResourceRecord{Value: aws.String(value)}
+	}
+
+	_, err := p.client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{
+				{
+					Action: aws.String(action),
+					ResourceRecordSet: &route53.ResourceRecordSet{
+						Name:            aws.String(name),
+						Type:            aws.String(recordType),
+						TTL:             aws.Int64(int64(ttl)),
+						ResourceRecords: resourceRecords,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error applying %s for %s record %s in Route53 zone %s: %s", action, recordType, name, zoneID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package incapsula
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Provider returns a schema.Provider for Incapsula
+func Provider() terraform.ResourceProvider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"api_id": &schema.Schema{
+				Description: "The API identifier for Incapsula account. Can be set via INCAPSULA_API_ID environment variable.",
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("INCAPSULA_API_ID", nil),
+			},
+			"api_key": &schema.Schema{
+				Description: "The API key for Incapsula account. Can be set via INCAPSULA_API_KEY environment variable.",
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("INCAPSULA_API_KEY", nil),
+			},
+			"base_url": &schema.Schema{
+				Description: "The Incapsula API endpoint to use. Can be set via INCAPSULA_BASE_URL environment variable.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("INCAPSULA_BASE_URL", "https://my.incapsula.com/api/prov/v1"),
+			},
+			"account_id": &schema.Schema{
+				Description: "Default numeric identifier of the account to operate on. Used for any resource that does not set its own account_id. Can be set via INCAPSULA_ACCOUNT_ID environment variable.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("INCAPSULA_ACCOUNT_ID", ""),
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"incapsula_site":       resourceSite(),
+			"incapsula_dns_record": resourceDNSRecord(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"incapsula_site_dns": dataSourceSiteDNS(),
+			"incapsula_site":     dataSourceSite(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(data *schema.ResourceData) (interface{}, error) {
+	config := &Config{
+		APIID:     data.Get("api_id").(string),
+		APIKey:    data.Get("api_key").(string),
+		Endpoint:  data.Get("base_url").(string),
+		AccountID: data.Get("account_id").(string),
+	}
+
+	return config.Client()
+}
@@ -0,0 +1,378 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceDNSRecord() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceDNSRecordCreate,
+		Read:          resourceDNSRecordRead,
+		Update:        resourceDNSRecordUpdate,
+		Delete:        resourceDNSRecordDelete,
+		CustomizeDiff: resourceDNSRecordCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": &schema.Schema{
+				Description: "Numeric identifier of the Incapsula site to publish DNS records for, for example incapsula_site.foo.id.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"dns_provider": &schema.Schema{
+				Description: "The DNS provider to publish records to. Options are route53 and clouddns.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"dns_zone_id": &schema.Schema{
+				Description: "Identifier of the zone to publish records into (the Route53 hosted zone ID or the Cloud DNS managed zone name).",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			// Optional Arguments
+			"ttl": &schema.Schema{
+				Description: "TTL, in seconds, for the published records.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     300,
+				ForceNew:    true,
+			},
+
+			// Computed Attributes
+			"domain": &schema.Schema{
+				Description: "The domain of the Incapsula site these records were published for.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"record": &schema.Schema{
+				Description: "The records last published to the DNS zone, tracked so that plan and Update can detect when Incapsula rotates the CNAME/A target and Delete can unpublish exactly what was published.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"values": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDNSRecordCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	siteID, _ := strconv.Atoi(d.Get("site_id").(string))
+
+	log.Printf("[INFO] Publishing DNS records for Incapsula site id: %d\n", siteID)
+
+	siteStatusResponse, err := client.SiteStatus("", siteID)
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	publisher, err := dnsPublisherForType(d.Get("dns_provider").(string))
+	if err != nil {
+		return err
+	}
+
+	records := siteDNSRecords(siteStatusResponse)
+
+	if err := publishRecords(publisher, d.Get("dns_zone_id").(string), d.Get("ttl").(int), records); err != nil {
+		log.Printf("[ERROR] Could not publish DNS records for Incapsula site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	d.SetId(strconv.Itoa(siteID))
+	d.Set("record", flattenSiteDNSRecords(records))
+
+	log.Printf("[INFO] Published DNS records for Incapsula site id: %d\n", siteID)
+
+	return resourceDNSRecordRead(d, m)
+}
+
+func resourceDNSRecordRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	siteID, _ := strconv.Atoi(d.Id())
+
+	log.Printf("[INFO] Reading Incapsula site id for DNS record: %d\n", siteID)
+
+	siteStatusResponse, err := client.SiteStatus("", siteID)
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	d.Set("site_id", strconv.Itoa(siteID))
+	d.Set("domain", siteStatusResponse.Domain)
+
+	// "record" is normally left untouched here: it holds what Create/Update
+	// actually published, and resourceDNSRecordCustomizeDiff needs that
+	// value to stay put so it can detect drift against the live site. The
+	// one time it's seeded here is right after `terraform import`, which
+	// uses schema.ImportStatePassthrough and so never runs Create (leaving
+	// dns_provider unset) - seed it from the live records in that case,
+	// otherwise Delete would have nothing to unpublish.
+	if len(d.Get("record").([]interface{})) == 0 && d.Get("dns_provider").(string) == "" {
+		d.Set("record", flattenSiteDNSRecords(siteDNSRecords(siteStatusResponse)))
+	}
+
+	return nil
+}
+
+// resourceDNSRecordCustomizeDiff forces a diff when Incapsula has rotated a
+// site's CNAME/A target since the last apply. Under the legacy helper/schema
+// diff engine, a Computed-only field changing server-side never produces a
+// diff on its own, so without this, resourceDNSRecordUpdate could never be
+// invoked by a normal plan/apply.
+func resourceDNSRecordCustomizeDiff(diff *schema.ResourceDiff, m interface{}) error {
+	// Nothing to compare against yet on create.
+	if diff.Id() == "" {
+		return nil
+	}
+
+	client := m.(*Client)
+
+	siteID, _ := strconv.Atoi(diff.Id())
+
+	siteStatusResponse, err := client.SiteStatus("", siteID)
+	if err != nil {
+		return fmt.Errorf("error checking Incapsula site id %d for DNS record drift: %s", siteID, err)
+	}
+
+	storedRecords := expandSiteDNSRecords(diff.Get("record").([]interface{}))
+	liveRecords := siteDNSRecords(siteStatusResponse)
+
+	if !recordsEqual(storedRecords, liveRecords) {
+		if err := diff.SetNewComputed("record"); err != nil {
+			return fmt.Errorf("error marking record as changed for Incapsula site id %d: %s", siteID, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceDNSRecordUpdate republishes a site's DNS records when Incapsula
+// has rotated the CNAME/A target since the last apply. It diffs the site's
+// live status against the records this resource last published (stored in
+// the "record" attribute) and only touches the zone when they've diverged.
+func resourceDNSRecordUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	siteID, _ := strconv.Atoi(d.Id())
+	zoneID := d.Get("dns_zone_id").(string)
+	ttl := d.Get("ttl").(int)
+
+	log.Printf("[INFO] Checking Incapsula site id %d for DNS record drift\n", siteID)
+
+	siteStatusResponse, err := client.SiteStatus("", siteID)
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	publisher, err := dnsPublisherForType(d.Get("dns_provider").(string))
+	if err != nil {
+		return err
+	}
+
+	storedRecords := expandSiteDNSRecords(d.Get("record").([]interface{}))
+	liveRecords := siteDNSRecords(siteStatusResponse)
+
+	if recordsEqual(storedRecords, liveRecords) {
+		log.Printf("[INFO] No DNS record drift detected for Incapsula site id: %d\n", siteID)
+		return resourceDNSRecordRead(d, m)
+	}
+
+	log.Printf("[INFO] Incapsula site id %d rotated its DNS records, republishing\n", siteID)
+
+	if err := unpublishRecords(publisher, zoneID, ttl, storedRecords); err != nil {
+		log.Printf("[ERROR] Could not unpublish stale DNS records for Incapsula site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	if err := publishRecords(publisher, zoneID, ttl, liveRecords); err != nil {
+		log.Printf("[ERROR] Could not publish DNS records for Incapsula site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	d.Set("record", flattenSiteDNSRecords(liveRecords))
+
+	return resourceDNSRecordRead(d, m)
+}
+
+func resourceDNSRecordDelete(d *schema.ResourceData, m interface{}) error {
+	siteID, _ := strconv.Atoi(d.Id())
+
+	log.Printf("[INFO] Unpublishing DNS records for Incapsula site id: %d\n", siteID)
+
+	publisher, err := dnsPublisherForType(d.Get("dns_provider").(string))
+	if err != nil {
+		return err
+	}
+
+	zoneID := d.Get("dns_zone_id").(string)
+	ttl := d.Get("ttl").(int)
+
+	// Unpublish the records this resource actually published, not whatever
+	// SiteStatus reports right now - if Incapsula has since rotated the
+	// CNAME/A target, a live lookup would no longer match what's in the
+	// zone and the delete-by-exact-match call to the DNS provider would fail.
+	storedRecords := expandSiteDNSRecords(d.Get("record").([]interface{}))
+
+	if err := unpublishRecords(publisher, zoneID, ttl, storedRecords); err != nil {
+		log.Printf("[ERROR] Could not unpublish DNS records for Incapsula site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] Unpublished DNS records for Incapsula site id: %d\n", siteID)
+
+	return nil
+}
+
+func publishRecords(publisher DNSPublisher, zoneID string, ttl int, records []siteDNSRecord) error {
+	for _, record := range records {
+		if err := publisher.PublishRecord(zoneID, record.name, record.recordType, record.values, ttl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unpublishRecords(publisher DNSPublisher, zoneID string, ttl int, records []siteDNSRecord) error {
+	for _, record := range records {
+		if err := publisher.UnpublishRecord(zoneID, record.name, record.recordType, record.values, ttl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type siteDNSRecord struct {
+	name       string
+	recordType string
+	values     []string
+}
+
+// siteDNSRecords extracts the CNAME/A records from a SiteStatusResponse in
+// the shape expected by DNSPublisher
+func siteDNSRecords(siteStatusResponse *SiteStatusResponse) []siteDNSRecord {
+	records := make([]siteDNSRecord, 0)
+
+	for _, entry := range siteStatusResponse.DNS {
+		if len(entry.SetDataTo) == 0 {
+			continue
+		}
+
+		switch entry.SetTypeTo {
+		case "CNAME", "A":
+			records = append(records, siteDNSRecord{
+				name:       entry.DNSRecordName,
+				recordType: entry.SetTypeTo,
+				values:     entry.SetDataTo,
+			})
+		}
+	}
+
+	return records
+}
+
+// recordsEqual compares two record sets irrespective of order, so a
+// same-content response from SiteStatus is never treated as drift
+func recordsEqual(a, b []siteDNSRecord) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	sortRecords := func(records []siteDNSRecord) []siteDNSRecord {
+		sorted := make([]siteDNSRecord, len(records))
+		copy(sorted, records)
+		sort.Slice(sorted, func(i, j int) bool {
+			return sorted[i].name+sorted[i].recordType < sorted[j].name+sorted[j].recordType
+		})
+		return sorted
+	}
+
+	sortedA := sortRecords(a)
+	sortedB := sortRecords(b)
+
+	for i := range sortedA {
+		if sortedA[i].name != sortedB[i].name || sortedA[i].recordType != sortedB[i].recordType {
+			return false
+		}
+		if !reflect.DeepEqual(sortedA[i].values, sortedB[i].values) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// flattenSiteDNSRecords converts siteDNSRecords into the []interface{} shape
+// expected by the "record" schema field
+func flattenSiteDNSRecords(records []siteDNSRecord) []interface{} {
+	flattened := make([]interface{}, len(records))
+
+	for i, record := range records {
+		flattened[i] = map[string]interface{}{
+			"name":   record.name,
+			"type":   record.recordType,
+			"values": record.values,
+		}
+	}
+
+	return flattened
+}
+
+// expandSiteDNSRecords converts the "record" schema field's raw
+// []interface{} value back into siteDNSRecords
+func expandSiteDNSRecords(raw []interface{}) []siteDNSRecord {
+	records := make([]siteDNSRecord, len(raw))
+
+	for i, item := range raw {
+		m := item.(map[string]interface{})
+
+		values := make([]string, 0)
+		for _, v := range m["values"].([]interface{}) {
+			values = append(values, v.(string))
+		}
+
+		records[i] = siteDNSRecord{
+			name:       m["name"].(string),
+			recordType: m["type"].(string),
+			values:     values,
+		}
+	}
+
+	return records
+}
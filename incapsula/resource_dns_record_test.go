@@ -0,0 +1,29 @@
+package incapsula
+
+import "testing"
+
+func TestSiteDNSRecords(t *testing.T) {
+	siteStatusResponse := &SiteStatusResponse{
+		DNS: []struct {
+			DNSRecordName string   `json:"dns_record_name"`
+			SetTypeTo     string   `json:"set_type_to"`
+			SetDataTo     []string `json:"set_data_to"`
+		}{
+			{DNSRecordName: "www.example.com", SetTypeTo: "CNAME", SetDataTo: []string{"site.example.impervadns.net"}},
+			{DNSRecordName: "www.example.com", SetTypeTo: "A", SetDataTo: []string{"1.2.3.4", "1.2.3.5"}},
+			{DNSRecordName: "www.example.com", SetTypeTo: "NS", SetDataTo: []string{"ignored"}},
+		},
+	}
+
+	records := siteDNSRecords(siteStatusResponse)
+
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+	if records[0].recordType != "CNAME" || records[1].recordType != "A" {
+		t.Fatalf("unexpected record types: %+v", records)
+	}
+	if len(records[1].values) != 2 {
+		t.Fatalf("expected 2 A record values, got %d", len(records[1].values))
+	}
+}
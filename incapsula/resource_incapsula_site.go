@@ -1,8 +1,10 @@
 package incapsula
 
 import (
+	"fmt"
 	"log"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -14,7 +16,7 @@ func resourceSite() *schema.Resource {
 		Update: resourceSiteUpdate,
 		Delete: resourceSiteDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceSiteImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -28,9 +30,11 @@ func resourceSite() *schema.Resource {
 
 			// Optional Arguments
 			"account_id": &schema.Schema{
-				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
+				Description: "Numeric identifier of the account to operate on. Defaults to the provider's account_id if set, and otherwise to the account identified by the authentication parameters. Incapsula does not support moving an existing site between accounts, so changing this recreates the site.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
 			},
 			"ref_id": &schema.Schema{
 				Description: "Customer specific identifier for this operation.",
@@ -62,6 +66,24 @@ func resourceSite() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"wait_for_dns": &schema.Schema{
+				Description: "Wait for the site's DNS and SSL provisioning to finish before returning from create. Downstream resources that depend on dns_cname_record_value or dns_a_record_value should enable this.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"create_timeout": &schema.Schema{
+				Description: "Timeout, expressed as a Go duration string (e.g. \"10m\"), to wait for site provisioning to complete when wait_for_dns is true.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "10m",
+			},
+			"update_timeout": &schema.Schema{
+				Description: "Timeout, expressed as a Go duration string (e.g. \"10m\"), to wait for site re-provisioning to complete after an update that changes site_ip, when wait_for_dns is true.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "10m",
+			},
 
 			// Computed Attributes
 			"site_creation_date": &schema.Schema{
@@ -100,12 +122,16 @@ func resourceSiteCreate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 
 	domain := d.Get("domain").(string)
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		accountID = client.AccountID()
+	}
 
 	log.Printf("[INFO] Creating Incapsula site for domain: %s\n", domain)
 
 	siteAddResponse, err := client.AddSite(
 		domain,
-		d.Get("account_id").(string),
+		accountID,
 		d.Get("ref_id").(string),
 		d.Get("send_site_setup_emails").(string),
 		d.Get("site_ip").(string),
@@ -122,12 +148,67 @@ func resourceSiteCreate(d *schema.ResourceData, m interface{}) error {
 	// Set the Site ID
 	d.SetId(strconv.Itoa(siteAddResponse.SiteID))
 
+	if d.Get("wait_for_dns").(bool) {
+		if err := waitForSiteProvisioning(client, d, domain, siteAddResponse.SiteID, "create_timeout"); err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[INFO] Created Incapsula site for domain: %s\n", domain)
 
 	// Set the rest of the state from the resource read
 	return resourceSiteRead(d, m)
 }
 
+// waitForSiteProvisioning blocks until the given site's asynchronous DNS and
+// SSL provisioning reaches a terminal state, using the timeout stored in the
+// named schema field
+func waitForSiteProvisioning(client *Client, d *schema.ResourceData, domain string, siteID int, timeoutField string) error {
+	timeout, err := time.ParseDuration(d.Get(timeoutField).(string))
+	if err != nil {
+		return fmt.Errorf("invalid %s %q: %s", timeoutField, d.Get(timeoutField).(string), err)
+	}
+
+	waiter := &SiteStatusWaiter{
+		Client:     client,
+		Domain:     domain,
+		SiteID:     siteID,
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	log.Printf("[INFO] Waiting for Incapsula site provisioning for domain: %s\n", domain)
+
+	if _, err := waiter.WaitForCompletion(); err != nil {
+		log.Printf("[ERROR] Incapsula site provisioning did not complete for domain: %s, %s\n", domain, err)
+		return err
+	}
+
+	return nil
+}
+
+// resourceSiteImport hydrates state for `terraform import incapsula_site.foo <site_id>`.
+// Unlike schema.ImportStatePassthrough, it rejects a non-numeric ID and looks
+// the site up by ID, setting its domain in state before resourceSiteRead runs.
+func resourceSiteImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*Client)
+
+	siteID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("invalid site id %q for import, expected a numeric id: %s", d.Id(), err)
+	}
+
+	siteStatusResponse, err := client.SiteStatus("", siteID)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up domain for site id %d during import: %s", siteID, err)
+	}
+
+	d.Set("domain", siteStatusResponse.Domain)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceSiteRead(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 
@@ -146,6 +227,14 @@ func resourceSiteRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("site_creation_date", siteStatusResponse.SiteCreationDate)
 	d.Set("domain", siteStatusResponse.Domain)
 
+	// When the resource leaves account_id empty, fall back to the
+	// provider-level default here too, so state reflects the account in
+	// effect rather than showing an empty string. The account_id in the
+	// SiteStatus response is not read back.
+	if d.Get("account_id").(string) == "" {
+		d.Set("account_id", client.AccountID())
+	}
+
 	// Set the DNS information
 	dnsARecordValues := make([]string, 0)
 	for _, entry := range siteStatusResponse.DNS {
@@ -166,8 +255,54 @@ func resourceSiteRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceSiteUpdate(d *schema.ResourceData, m interface{}) error {
-	// Not implemented
-	return nil
+	client := m.(*Client)
+
+	domain := d.Get("domain").(string)
+	siteID, _ := strconv.Atoi(d.Id())
+
+	log.Printf("[INFO] Updating Incapsula site for domain: %s\n", domain)
+
+	d.Partial(true)
+
+	updates := []struct {
+		field  string
+		update func() error
+	}{
+		{"site_ip", func() error { return client.UpdateSiteIP(siteID, d.Get("site_ip").(string)) }},
+		{"force_ssl", func() error { return client.UpdateSiteForceSSL(siteID, d.Get("force_ssl").(string)) }},
+		{"log_level", func() error { return client.UpdateSiteLogLevel(siteID, d.Get("log_level").(string)) }},
+		{"logs_account_id", func() error { return client.UpdateSiteLogsAccountID(siteID, d.Get("logs_account_id").(string)) }},
+		{"ref_id", func() error { return client.UpdateSiteRefID(siteID, d.Get("ref_id").(string)) }},
+		{"send_site_setup_emails", func() error {
+			return client.UpdateSiteSendSiteSetupEmails(siteID, d.Get("send_site_setup_emails").(string))
+		}},
+	}
+
+	for _, u := range updates {
+		if !d.HasChange(u.field) {
+			continue
+		}
+
+		if err := u.update(); err != nil {
+			log.Printf("[ERROR] Could not update %s for Incapsula site for domain: %s, %s\n", u.field, domain, err)
+			return err
+		}
+
+		d.SetPartial(u.field)
+	}
+
+	d.Partial(false)
+
+	if d.HasChange("site_ip") && d.Get("wait_for_dns").(bool) {
+		if err := waitForSiteProvisioning(client, d, domain, siteID, "update_timeout"); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[INFO] Updated Incapsula site for domain: %s\n", domain)
+
+	// Set the rest of the state from the resource read
+	return resourceSiteRead(d, m)
 }
 
 func resourceSiteDelete(d *schema.ResourceData, m interface{}) error {
@@ -0,0 +1,51 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIncapsulaSite_Update(t *testing.T) {
+	domain := "terraform-update-test.example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaSiteConfig(domain, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("incapsula_site.test-site", "force_ssl", "false"),
+					resource.TestCheckResourceAttr("incapsula_site.test-site", "log_level", "default"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaSiteConfigUpdated(domain, "full"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("incapsula_site.test-site", "force_ssl", "true"),
+					resource.TestCheckResourceAttr("incapsula_site.test-site", "log_level", "full"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIncapsulaSiteConfig(domain, logLevel string) string {
+	return fmt.Sprintf(`
+resource "incapsula_site" "test-site" {
+  domain    = "%s"
+  force_ssl = "false"
+  log_level = "%s"
+}`, domain, logLevel)
+}
+
+func testAccCheckIncapsulaSiteConfigUpdated(domain, logLevel string) string {
+	return fmt.Sprintf(`
+resource "incapsula_site" "test-site" {
+  domain    = "%s"
+  force_ssl = "true"
+  log_level = "%s"
+}`, domain, logLevel)
+}
@@ -0,0 +1,78 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Site status values reported by the Incapsula API
+const (
+	siteStatusBypassed         = "bypassed"
+	siteStatusPendingSelectDNS = "pending-select-dns"
+	siteStatusActive           = "active"
+	siteStatusError            = "error"
+)
+
+// SiteStatusWaiter polls SiteStatus until a site transitions out of its
+// pending provisioning states, mirroring the operation-waiter pattern used
+// to track other asynchronous Incapsula/Terraform provider operations.
+type SiteStatusWaiter struct {
+	Client  *Client
+	Domain  string
+	SiteID  int
+
+	Timeout    time.Duration
+	MinTimeout time.Duration
+	Delay      time.Duration
+}
+
+// RefreshFunc returns a resource.StateRefreshFunc that reports the current
+// site status, to be driven by a resource.StateChangeConf
+func (w *SiteStatusWaiter) RefreshFunc() resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		siteStatusResponse, err := w.Client.SiteStatus(w.Domain, w.SiteID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] Incapsula site %d status: %s\n", w.SiteID, siteStatusResponse.Status)
+
+		if siteStatusResponse.Status == siteStatusError {
+			return siteStatusResponse, siteStatusResponse.Status, fmt.Errorf("site %d entered error state during provisioning", w.SiteID)
+		}
+
+		return siteStatusResponse, siteStatusResponse.Status, nil
+	}
+}
+
+// Conf builds the resource.StateChangeConf used to wait for site provisioning
+// to leave its pending states and reach a terminal state
+func (w *SiteStatusWaiter) Conf() *resource.StateChangeConf {
+	return &resource.StateChangeConf{
+		Pending:    []string{siteStatusBypassed, siteStatusPendingSelectDNS},
+		Target:     []string{siteStatusActive},
+		Refresh:    w.RefreshFunc(),
+		Timeout:    w.Timeout,
+		MinTimeout: w.MinTimeout,
+		Delay:      w.Delay,
+	}
+}
+
+// WaitForCompletion blocks until the site reaches the active state, or
+// returns an error if it enters an error state or times out
+func (w *SiteStatusWaiter) WaitForCompletion() (*SiteStatusResponse, error) {
+	result, err := w.Conf().WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for site %d to finish provisioning: %s", w.SiteID, err)
+	}
+
+	siteStatusResponse, ok := result.(*SiteStatusResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type returned while waiting for site %d to finish provisioning", w.SiteID)
+	}
+
+	return siteStatusResponse, nil
+}
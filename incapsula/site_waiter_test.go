@@ -0,0 +1,20 @@
+package incapsula
+
+import "testing"
+
+func TestSiteStatusWaiterConf(t *testing.T) {
+	waiter := &SiteStatusWaiter{
+		Client: &Client{},
+		Domain: "example.com",
+		SiteID: 42,
+	}
+
+	conf := waiter.Conf()
+
+	if len(conf.Pending) != 2 {
+		t.Fatalf("expected 2 pending states, got %d", len(conf.Pending))
+	}
+	if conf.Target[0] != siteStatusActive {
+		t.Fatalf("expected target state %q, got %q", siteStatusActive, conf.Target[0])
+	}
+}
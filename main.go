@@ -0,0 +1,12 @@
+package main
+
+import (
+	"github.com/cgriggs01/terraform-provider-incapsula/incapsula"
+	"github.com/hashicorp/terraform/plugin"
+)
+
+func main() {
+	plugin.Serve(&plugin.ServeOpts{
+		ProviderFunc: incapsula.Provider,
+	})
+}